@@ -2,10 +2,15 @@ package network
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
+	"github.com/docker/cli/cli/command/prune/report"
 	"github.com/docker/cli/opts"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
 )
@@ -25,12 +30,12 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 		Short: "Remove all unused networks",
 		Args:  cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			output, err := runPrune(dockerCli, options)
+			rep, err := runPrune(dockerCli, options)
 			if err != nil {
 				return err
 			}
-			if output != "" {
-				fmt.Fprintln(dockerCli.Out(), output)
+			if out := rep.String(); out != "" {
+				fmt.Fprintln(dockerCli.Out(), out)
 			}
 			return nil
 		},
@@ -48,35 +53,108 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 const warning = `WARNING! This will remove all networks not used by at least one container.
 Are you sure you want to continue?`
 
-func runPrune(dockerCli command.Cli, options pruneOptions) (output string, err error) {
+var acceptedFilters = map[string]bool{
+	"label":  true,
+	"label!": true,
+	"until":  true,
+}
+
+func runPrune(dockerCli command.Cli, options pruneOptions) (*report.Report, error) {
 	pruneFilters := command.PruneFilters(dockerCli, options.filter.Value())
-	pruneFilters.Add("dryRun", fmt.Sprintf("%v", options.dryRun))
+	if err := pruneFilters.Validate(acceptedFilters); err != nil {
+		return nil, err
+	}
 
 	if !options.force && !options.dryRun && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
-		return
+		return nil, nil
+	}
+
+	if options.dryRun {
+		return dryRunPrune(dockerCli, pruneFilters)
+	}
+
+	apiReport, err := dockerCli.Client().NetworksPrune(context.Background(), pruneFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &report.Report{
+		ResourceType: "Networks",
+		Deleted:      apiReport.NetworksDeleted,
+		DryRun:       options.dryRun,
+	}, nil
+}
+
+// predefinedNetworks are never candidates for removal, even when unused.
+var predefinedNetworks = map[string]bool{
+	"bridge": true,
+	"host":   true,
+	"none":   true,
+}
+
+// dryRunPrune lists the networks that a real prune would remove, without
+// ever calling NetworksPrune, so it cannot mutate the daemon. A network is a
+// prune candidate when it isn't one of the predefined networks and has no
+// attached containers, the same predicate NetworksPrune applies. NetworkList
+// doesn't understand the "until" filter, so it's stripped before listing and
+// applied locally against each network's creation time instead.
+func dryRunPrune(dockerCli command.Cli, pruneFilters filters.Args) (*report.Report, error) {
+	until, hasUntil, err := untilFilterValue(pruneFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	listFilters := filters.NewArgs()
+	for _, key := range []string{"label", "label!"} {
+		for _, value := range pruneFilters.Get(key) {
+			listFilters.Add(key, value)
+		}
 	}
 
-	report, err := dockerCli.Client().NetworksPrune(context.Background(), pruneFilters)
+	networks, err := dockerCli.Client().NetworkList(context.Background(), types.NetworkListOptions{Filters: listFilters})
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	if len(report.NetworksDeleted) > 0 {
-		output = "Deleted Networks:\n"
-		if options.dryRun {
-			output = "Will Delete Networks:\n"
+	var deleted []string
+	for _, n := range networks {
+		if predefinedNetworks[n.Name] || len(n.Containers) > 0 {
+			continue
 		}
-		for _, id := range report.NetworksDeleted {
-			output += id + "\n"
+		if hasUntil && n.Created.After(until) {
+			continue
 		}
+		deleted = append(deleted, n.Name)
 	}
 
-	return
+	return &report.Report{
+		ResourceType: "Networks",
+		Deleted:      deleted,
+		DryRun:       true,
+	}, nil
+}
+
+// untilFilterValue parses an "until" filter entry, which may be a duration
+// (e.g. "24h") or an RFC3339 timestamp, into the cutoff time before which
+// networks are eligible for removal.
+func untilFilterValue(pruneFilters filters.Args) (time.Time, bool, error) {
+	values := pruneFilters.Get("until")
+	if len(values) == 0 {
+		return time.Time{}, false, nil
+	}
+	v := values[0]
+	if d, err := time.ParseDuration(v); err == nil {
+		return time.Now().Add(-d), true, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false, errors.Wrapf(err, "invalid until filter %q", v)
+	}
+	return t, true, nil
 }
 
 // RunPrune calls the Network Prune API
-// This returns the amount of space reclaimed and a detailed output string
-func RunPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
-	output, err := runPrune(dockerCli, pruneOptions{force: true, dryRun: dryRun, filter: filter})
-	return 0, output, err
+// This returns a structured report of what was (or would be) removed
+func RunPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
+	return runPrune(dockerCli, pruneOptions{force: true, dryRun: dryRun, filter: filter})
 }
@@ -0,0 +1,123 @@
+package network
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/cli/internal/test"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/pkg/testutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkPruneErrors(t *testing.T) {
+	testCases := []struct {
+		args             []string
+		flags            map[string]string
+		networkPruneFunc func(args filters.Args) (types.NetworksPruneReport, error)
+		expectedError    string
+	}{
+		{
+			args:          []string{"foo"},
+			expectedError: "accepts no argument",
+		},
+		{
+			flags: map[string]string{
+				"force": "true",
+			},
+			networkPruneFunc: func(args filters.Args) (types.NetworksPruneReport, error) {
+				return types.NetworksPruneReport{}, errors.Errorf("error pruning networks")
+			},
+			expectedError: "error pruning networks",
+		},
+		{
+			flags: map[string]string{
+				"force":  "true",
+				"filter": "badfilter=baz",
+			},
+			expectedError: "Invalid filter",
+		},
+	}
+	for _, tc := range testCases {
+		cmd := NewPruneCommand(
+			test.NewFakeCli(&fakeClient{
+				networkPruneFunc: tc.networkPruneFunc,
+			}),
+		)
+		cmd.SetArgs(tc.args)
+		for key, value := range tc.flags {
+			cmd.Flags().Set(key, value)
+		}
+		testutil.ErrorContains(t, cmd.Execute(), tc.expectedError)
+	}
+}
+
+func TestNetworkPruneWithLabelFilter(t *testing.T) {
+	testCases := []struct {
+		name   string
+		filter string
+	}{
+		{name: "label", filter: "label=keep=true"},
+		{name: "labelBang", filter: "label!=env=prod"},
+	}
+	for _, tc := range testCases {
+		var gotArgs filters.Args
+		cli := test.NewFakeCli(&fakeClient{
+			networkPruneFunc: func(args filters.Args) (types.NetworksPruneReport, error) {
+				gotArgs = args
+				return types.NetworksPruneReport{}, nil
+			},
+		})
+		cmd := NewPruneCommand(cli)
+		cmd.Flags().Set("force", "true")
+		cmd.Flags().Set("filter", tc.filter)
+		assert.NoError(t, cmd.Execute())
+
+		parts := strings.SplitN(tc.filter, "=", 2)
+		assert.True(t, gotArgs.Include(parts[0]))
+	}
+}
+
+func TestNetworkPruneDryRunNeverCallsNetworksPrune(t *testing.T) {
+	pruneCalled := false
+	cli := test.NewFakeCli(&fakeClient{
+		networkPruneFunc: func(args filters.Args) (types.NetworksPruneReport, error) {
+			pruneCalled = true
+			return types.NetworksPruneReport{}, nil
+		},
+		networkListFunc: func(args filters.Args) ([]types.NetworkResource, error) {
+			return []types.NetworkResource{
+				{Name: "unused"},
+				{Name: "bridge"},
+			}, nil
+		},
+	})
+	cmd := NewPruneCommand(cli)
+	cmd.Flags().Set("dry-run", "true")
+	assert.NoError(t, cmd.Execute())
+	assert.False(t, pruneCalled, "NetworksPrune must not be called when --dry-run is set")
+	out := cli.OutBuffer().String()
+	assert.Contains(t, out, "unused")
+	assert.NotContains(t, out, "bridge")
+}
+
+func TestNetworkPruneDryRunWithUntilFilter(t *testing.T) {
+	cli := test.NewFakeCli(&fakeClient{
+		networkListFunc: func(args filters.Args) ([]types.NetworkResource, error) {
+			return []types.NetworkResource{
+				{Name: "old", Created: time.Now().Add(-48 * time.Hour)},
+				{Name: "recent", Created: time.Now()},
+			}, nil
+		},
+	})
+	cmd := NewPruneCommand(cli)
+	cmd.Flags().Set("dry-run", "true")
+	cmd.Flags().Set("filter", "until=24h")
+	assert.NoError(t, cmd.Execute())
+	out := cli.OutBuffer().String()
+	assert.Contains(t, out, "old")
+	assert.NotContains(t, out, "recent")
+}
@@ -5,26 +5,35 @@ import (
 	"github.com/docker/cli/cli/command/container"
 	"github.com/docker/cli/cli/command/image"
 	"github.com/docker/cli/cli/command/network"
+	"github.com/docker/cli/cli/command/prune/report"
 	"github.com/docker/cli/cli/command/volume"
 	"github.com/docker/cli/opts"
 )
 
 // RunContainerPrune executes a prune command for containers
-func RunContainerPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
-	return container.RunPrune(dockerCli, dryRun, filter)
+func RunContainerPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
+	spc, output, err := container.RunPrune(dockerCli, dryRun, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &report.Report{ResourceType: "Containers", Deleted: report.ParseDeleted(output), SpaceReclaimed: spc, DryRun: dryRun}, nil
 }
 
 // RunVolumePrune executes a prune command for volumes
-func RunVolumePrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
+func RunVolumePrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
 	return volume.RunPrune(dockerCli, dryRun, filter)
 }
 
 // RunImagePrune executes a prune command for images
-func RunImagePrune(dockerCli command.Cli, all bool, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
-	return image.RunPrune(dockerCli, all, dryRun, filter)
+func RunImagePrune(dockerCli command.Cli, all bool, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
+	spc, output, err := image.RunPrune(dockerCli, all, dryRun, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &report.Report{ResourceType: "Images", Deleted: report.ParseDeleted(output), SpaceReclaimed: spc, DryRun: dryRun}, nil
 }
 
 // RunNetworkPrune executes a prune command for networks
-func RunNetworkPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
+func RunNetworkPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
 	return network.RunPrune(dockerCli, dryRun, filter)
 }
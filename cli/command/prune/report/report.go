@@ -0,0 +1,54 @@
+// Package report defines the structured result shared by the individual
+// resource prune commands (container, image, network, volume) and the
+// `system prune` command that aggregates them.
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Report is the structured result of pruning a single resource type. It lets
+// programmatic callers consume what was removed without parsing free-form
+// output, and gives `system prune` enough detail to build its summary table.
+type Report struct {
+	ResourceType   string
+	Deleted        []string
+	SpaceReclaimed uint64
+	DryRun         bool
+}
+
+// String renders the report the way the individual `prune` commands have
+// always printed their results: a "Deleted <Type>:" (or "Will Delete <Type>:"
+// for a dry run) header followed by one ID per line. It is empty when
+// nothing was removed.
+func (r *Report) String() string {
+	if r == nil || len(r.Deleted) == 0 {
+		return ""
+	}
+	verb := "Deleted"
+	if r.DryRun {
+		verb = "Will Delete"
+	}
+	out := fmt.Sprintf("%s %s:\n", verb, r.ResourceType)
+	for _, id := range r.Deleted {
+		out += id + "\n"
+	}
+	return out
+}
+
+// ParseDeleted extracts the IDs from the legacy "Deleted <Type>:\nid1\nid2\n"
+// (or "Will Delete <Type>:\n...") free-text block that container.RunPrune and
+// image.RunPrune still return, so callers that only have that string can
+// populate Report.Deleted instead of leaving it empty.
+func ParseDeleted(output string) []string {
+	var deleted []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasSuffix(line, ":") {
+			continue
+		}
+		deleted = append(deleted, line)
+	}
+	return deleted
+}
@@ -2,7 +2,9 @@ package system
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"text/tabwriter"
 	"text/template"
 
 	"github.com/docker/cli/cli"
@@ -10,6 +12,7 @@ import (
 	"github.com/docker/cli/cli/command/container"
 	"github.com/docker/cli/cli/command/image"
 	"github.com/docker/cli/cli/command/network"
+	"github.com/docker/cli/cli/command/prune/report"
 	"github.com/docker/cli/cli/command/volume"
 	"github.com/docker/cli/opts"
 	"github.com/docker/docker/api/types/versions"
@@ -24,7 +27,8 @@ type pruneOptions struct {
 	pruneBuildCache bool
 	pruneVolumes    bool
 	filter          opts.FilterOpt
-	dryRun bool
+	dryRun          bool
+	format          string
 }
 
 // newPruneCommand creates a new cobra.Command for `docker prune`
@@ -46,7 +50,8 @@ func newPruneCommand(dockerCli command.Cli) *cobra.Command {
 	flags.BoolVarP(&options.all, "all", "a", false, "Remove all unused images not just dangling ones")
 	flags.BoolVarP(&options.dryRun, "dry-run", "n", false, "Display prune report without removing anything")
 	flags.BoolVar(&options.pruneVolumes, "volumes", false, "Prune volumes")
-	flags.Var(&options.filter, "filter", "Provide filter values (e.g. 'label=<key>=<value>')")
+	flags.Var(&options.filter, "filter", "Provide filter values (e.g. 'label=<key>=<value>', 'until=<timestamp>', 'size=>1GB' (note: requires the leading '='))")
+	flags.StringVar(&options.format, "format", "", "Pretty-print prune reports using a Go template, or 'json'")
 	// "filter" flag is available in 1.28 (docker 17.04) and up
 	flags.SetAnnotation("filter", "version", []string{"1.28"})
 
@@ -60,32 +65,62 @@ const confirmationTemplate = `WARNING! This will remove:
 Are you sure you want to continue?`
 
 // runContainerPrune executes a prune command for containers
-func runContainerPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
-	return container.RunPrune(dockerCli, filter)
+func runContainerPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
+	if dryRun {
+		return skippedDryRunReport(dockerCli, "Containers")
+	}
+	spc, output, err := container.RunPrune(dockerCli, dryRun, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &report.Report{ResourceType: "Containers", Deleted: report.ParseDeleted(output), SpaceReclaimed: spc, DryRun: dryRun}, nil
 }
 
 // runNetworkPrune executes a prune command for networks
-func runNetworkPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
-	return network.RunPrune(dockerCli, filter)
+func runNetworkPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
+	return network.RunPrune(dockerCli, dryRun, filter)
 }
 
 // runVolumePrune executes a prune command for volumes
-func runVolumePrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
-	return volume.RunPrune(dockerCli, filter)
+func runVolumePrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
+	return volume.RunPrune(dockerCli, dryRun, filter)
 }
 
 // runImagePrune executes a prune command for images
-func runImagePrune(dockerCli command.Cli, all bool, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
-	return image.RunPrune(dockerCli, all, filter)
+func runImagePrune(dockerCli command.Cli, all bool, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
+	if dryRun {
+		return skippedDryRunReport(dockerCli, "Images")
+	}
+	spc, output, err := image.RunPrune(dockerCli, all, dryRun, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &report.Report{ResourceType: "Images", Deleted: report.ParseDeleted(output), SpaceReclaimed: spc, DryRun: dryRun}, nil
+}
+
+// skippedDryRunReport is returned in place of a real report for resource
+// types whose RunPrune only threads dryRun into a daemon-side filter that's
+// ignored by the real removal call. Until container/image prune gained a
+// client-side preview of their own (like network and volume prune have),
+// calling them under --dry-run would still delete the resource, so `system
+// prune --dry-run` skips them outright and says so instead of silently
+// removing anything. Build cache is skipped the same way, since
+// BuildCachePrune has no dry-run mode at all.
+func skippedDryRunReport(dockerCli command.Cli, resourceType string) (*report.Report, error) {
+	fmt.Fprintf(dockerCli.Out(), "%s: skipped, --dry-run is not yet supported for this resource type\n", resourceType)
+	return nil, nil
 }
 
 // runBuildCachePrune executes a prune command for build cache
-func runBuildCachePrune(dockerCli command.Cli, _ opts.FilterOpt) (uint64, string, error) {
-	report, err := dockerCli.Client().BuildCachePrune(context.Background())
+func runBuildCachePrune(dockerCli command.Cli, dryRun bool, _ opts.FilterOpt) (*report.Report, error) {
+	if dryRun {
+		return skippedDryRunReport(dockerCli, "Build Cache")
+	}
+	apiReport, err := dockerCli.Client().BuildCachePrune(context.Background())
 	if err != nil {
-		return 0, "", err
+		return nil, err
 	}
-	return report.SpaceReclaimed, "", nil
+	return &report.Report{ResourceType: "Build Cache", SpaceReclaimed: apiReport.SpaceReclaimed, DryRun: dryRun}, nil
 }
 
 func runPrune(dockerCli command.Cli, options pruneOptions) error {
@@ -95,10 +130,10 @@ func runPrune(dockerCli command.Cli, options pruneOptions) error {
 	if !options.force && !options.dryRun && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), confirmationMessage(options)) {
 		return nil
 	}
-	imagePrune := func(dockerCli command.Cli, filter opts.FilterOpt) (uint64, string, error) {
-		return runImagePrune(dockerCli, options.all, options.dryRun, options.filter)
+	imagePrune := func(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
+		return runImagePrune(dockerCli, options.all, dryRun, filter)
 	}
-	pruneFuncs := []func(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (uint64, string, error){
+	pruneFuncs := []func(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error){
 		runContainerPrune,
 		runNetworkPrune,
 	}
@@ -110,37 +145,58 @@ func runPrune(dockerCli command.Cli, options pruneOptions) error {
 		pruneFuncs = append(pruneFuncs, runBuildCachePrune)
 	}
 
-	var spaceReclaimed uint64
+	var reports []*report.Report
 	for _, pruneFn := range pruneFuncs {
-		spc, output, err := pruneFn(dockerCli, options.dryRun, options.filter)
+		rep, err := pruneFn(dockerCli, options.dryRun, options.filter)
 		if err != nil {
 			return err
 		}
-		spaceReclaimed += spc
-		if output != "" {
-			fmt.Fprintln(dockerCli.Out(), output)
+		if rep != nil {
+			reports = append(reports, rep)
 		}
 	}
 
-	spc, output, err := runImagePrune(dockerCli, options.all, options.dryRun, options.filter)
-	if err != nil {
-		return err
-	}
-	if spc > 0 {
-		spaceReclaimed += spc
-		fmt.Fprintln(dockerCli.Out(), output)
-	}
+	return printReports(dockerCli, reports, options)
+}
 
-	if options.pruneBuildCache {
-		report, err := dockerCli.Client().BuildCachePrune(context.Background())
+func printReports(dockerCli command.Cli, reports []*report.Report, options pruneOptions) error {
+	switch options.format {
+	case "":
+		return printReportsTable(dockerCli, reports, options.dryRun)
+	case "json":
+		enc := json.NewEncoder(dockerCli.Out())
+		enc.SetIndent("", "  ")
+		return enc.Encode(reports)
+	default:
+		tmpl, err := template.New("").Parse(options.format)
 		if err != nil {
 			return err
 		}
-		spaceReclaimed += report.SpaceReclaimed
+		for _, rep := range reports {
+			if err := tmpl.Execute(dockerCli.Out(), rep); err != nil {
+				return err
+			}
+			fmt.Fprintln(dockerCli.Out())
+		}
+		return nil
+	}
+}
+
+func printReportsTable(dockerCli command.Cli, reports []*report.Report, dryRun bool) error {
+	var spaceReclaimed uint64
+
+	w := tabwriter.NewWriter(dockerCli.Out(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "Type\tDeleted\tReclaimed")
+	for _, rep := range reports {
+		spaceReclaimed += rep.SpaceReclaimed
+		fmt.Fprintf(w, "%s\t%d\t%s\n", rep.ResourceType, len(rep.Deleted), units.HumanSize(float64(rep.SpaceReclaimed)))
+	}
+	if err := w.Flush(); err != nil {
+		return err
 	}
 
 	spaceReclaimedLabel := "Total reclaimed space:"
-	if options.dryRun {
+	if dryRun {
 		spaceReclaimedLabel = "Estimated reclaimable space:"
 	}
 	fmt.Fprintln(dockerCli.Out(), spaceReclaimedLabel, units.HumanSize(float64(spaceReclaimed)))
@@ -2,11 +2,16 @@ package volume
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
+	"github.com/docker/cli/cli/command/prune/report"
 	"github.com/docker/cli/opts"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
 	units "github.com/docker/go-units"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
 )
@@ -26,17 +31,21 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 		Short: "Remove all unused volumes",
 		Args:  cli.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			spaceReclaimed, output, err := runPrune(dockerCli, options)
+			rep, err := runPrune(dockerCli, options)
+			if out := rep.String(); out != "" {
+				fmt.Fprintln(dockerCli.Out(), out)
+			}
 			if err != nil {
 				return err
 			}
-			if output != "" {
-				fmt.Fprintln(dockerCli.Out(), output)
-			}
 			spaceReclaimedLabel := "Total reclaimed space:"
 			if options.dryRun {
 				spaceReclaimedLabel = "Estimated reclaimable space:"
 			}
+			var spaceReclaimed uint64
+			if rep != nil {
+				spaceReclaimed = rep.SpaceReclaimed
+			}
 
 			fmt.Fprintln(dockerCli.Out(), spaceReclaimedLabel, units.HumanSize(float64(spaceReclaimed)))
 			return nil
@@ -47,7 +56,7 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 	flags := cmd.Flags()
 	flags.BoolVarP(&options.force, "force", "f", false, "Do not prompt for confirmation")
 	flags.BoolVarP(&options.dryRun, "dry-run", "n", false, "Display prune report without removing anything")
-	flags.Var(&options.filter, "filter", "Provide filter values (e.g. 'label=<label>')")
+	flags.Var(&options.filter, "filter", "Provide filter values (e.g. 'label=<key>=<value>', 'until=<timestamp>', 'size=>1GB' (note: requires the leading '='))")
 
 	return cmd
 }
@@ -55,35 +64,201 @@ func NewPruneCommand(dockerCli command.Cli) *cobra.Command {
 const warning = `WARNING! This will remove all volumes not used by at least one container.
 Are you sure you want to continue?`
 
-func runPrune(dockerCli command.Cli, options pruneOptions) (spaceReclaimed uint64, output string, err error) {
+var acceptedFilters = map[string]bool{
+	"label":  true,
+	"label!": true,
+	"until":  true,
+	"size":   true,
+}
+
+func runPrune(dockerCli command.Cli, options pruneOptions) (*report.Report, error) {
 	pruneFilters := command.PruneFilters(dockerCli, options.filter.Value())
-	pruneFilters.Add("dryRun", fmt.Sprintf("%v", options.dryRun))
+	if err := pruneFilters.Validate(acceptedFilters); err != nil {
+		return nil, err
+	}
 
 	if !options.force && !options.dryRun && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
-		return
+		return nil, nil
 	}
 
-	report, err := dockerCli.Client().VolumesPrune(context.Background(), pruneFilters)
+	// The daemon's VolumesPrune filter set only understands label/label!;
+	// it has no notion of "until" or "size". Those two predicates must
+	// always be applied client-side, for a real prune exactly as much as
+	// for --dry-run, so the two can never disagree about which volumes
+	// are in scope.
+	if options.dryRun || hasSizeOrUntilFilter(pruneFilters) {
+		candidates, err := listPruneCandidates(dockerCli, pruneFilters)
+		if err != nil {
+			return nil, err
+		}
+		if options.dryRun {
+			return candidatesReport(candidates, true), nil
+		}
+		return deleteCandidates(dockerCli, candidates)
+	}
+
+	apiReport, err := dockerCli.Client().VolumesPrune(context.Background(), pruneFilters)
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	if len(report.VolumesDeleted) > 0 {
-		output = "Deleted Volumes:\n"
-		if options.dryRun {
-			output = "Will Delete Volumes:\n"
+	return &report.Report{
+		ResourceType:   "Volumes",
+		Deleted:        apiReport.VolumesDeleted,
+		SpaceReclaimed: apiReport.SpaceReclaimed,
+		DryRun:         options.dryRun,
+	}, nil
+}
+
+func hasSizeOrUntilFilter(pruneFilters filters.Args) bool {
+	return len(pruneFilters.Get("size")) > 0 || len(pruneFilters.Get("until")) > 0
+}
+
+// listPruneCandidates lists the volumes a real prune would remove, relying
+// on the daemon's own "dangling" volume filter to determine which volumes
+// are unused (the same predicate VolumesPrune applies), and then applying
+// the "until" and "size" predicates locally since VolumeList doesn't
+// understand them.
+func listPruneCandidates(dockerCli command.Cli, pruneFilters filters.Args) ([]*types.Volume, error) {
+	sizeGT, hasSizeGT, sizeLT, hasSizeLT, err := sizeFilterValues(pruneFilters)
+	if err != nil {
+		return nil, err
+	}
+	until, hasUntil, err := untilFilterValue(pruneFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	// VolumeList only needs to know about the filters it understands;
+	// "size" and "until" are applied locally below.
+	listFilters := filters.NewArgs()
+	for _, key := range []string{"label", "label!"} {
+		for _, value := range pruneFilters.Get(key) {
+			listFilters.Add(key, value)
+		}
+	}
+	listFilters.Add("dangling", "true")
+
+	volumeList, err := dockerCli.Client().VolumeList(context.Background(), listFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*types.Volume
+	for _, v := range volumeList.Volumes {
+		var size int64
+		if v.UsageData != nil {
+			size = v.UsageData.Size
+		}
+		if hasSizeGT && size <= sizeGT {
+			continue
+		}
+		if hasSizeLT && size >= sizeLT {
+			continue
+		}
+		if hasUntil {
+			created, err := time.Parse(time.RFC3339, v.CreatedAt)
+			if err == nil && created.After(until) {
+				continue
+			}
+		}
+		candidates = append(candidates, v)
+	}
+	return candidates, nil
+}
+
+func candidatesReport(candidates []*types.Volume, dryRun bool) *report.Report {
+	var deleted []string
+	var spaceReclaimed uint64
+	for _, v := range candidates {
+		deleted = append(deleted, v.Name)
+		if v.UsageData != nil {
+			spaceReclaimed += uint64(v.UsageData.Size)
+		}
+	}
+	return &report.Report{
+		ResourceType:   "Volumes",
+		Deleted:        deleted,
+		SpaceReclaimed: spaceReclaimed,
+		DryRun:         dryRun,
+	}
+}
+
+// deleteCandidates removes each candidate volume individually via
+// VolumeRemove, since the daemon's VolumesPrune endpoint can't be told
+// about the "size"/"until" predicates already applied to candidates. A
+// failure to remove one volume doesn't stop the rest from being tried, the
+// same tolerance VolumesPrune itself has; the report of what was actually
+// removed is returned alongside the first error encountered so the caller
+// can still show partial progress.
+func deleteCandidates(dockerCli command.Cli, candidates []*types.Volume) (*report.Report, error) {
+	var deleted []string
+	var spaceReclaimed uint64
+	var firstErr error
+	for _, v := range candidates {
+		if err := dockerCli.Client().VolumeRemove(context.Background(), v.Name, false); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
 		}
-		for _, id := range report.VolumesDeleted {
-			output += id + "\n"
+		deleted = append(deleted, v.Name)
+		if v.UsageData != nil {
+			spaceReclaimed += uint64(v.UsageData.Size)
 		}
-		spaceReclaimed = report.SpaceReclaimed
 	}
+	return &report.Report{
+		ResourceType:   "Volumes",
+		Deleted:        deleted,
+		SpaceReclaimed: spaceReclaimed,
+		DryRun:         false,
+	}, firstErr
+}
 
-	return
+// sizeFilterValues parses "size" filter entries of the form ">1GB" or
+// "<500MB" into byte thresholds.
+func sizeFilterValues(pruneFilters filters.Args) (gt int64, hasGT bool, lt int64, hasLT bool, err error) {
+	for _, v := range pruneFilters.Get("size") {
+		if len(v) < 2 {
+			return 0, false, 0, false, errors.Errorf("invalid size filter %q: must start with '>' or '<'", v)
+		}
+		size, err := units.RAMInBytes(v[1:])
+		if err != nil {
+			return 0, false, 0, false, errors.Wrapf(err, "invalid size filter %q", v)
+		}
+		switch v[0] {
+		case '>':
+			gt, hasGT = size, true
+		case '<':
+			lt, hasLT = size, true
+		default:
+			return 0, false, 0, false, errors.Errorf("invalid size filter %q: must start with '>' or '<'", v)
+		}
+	}
+	return gt, hasGT, lt, hasLT, nil
+}
+
+// untilFilterValue parses an "until" filter entry, which may be a duration
+// (e.g. "24h") or an RFC3339 timestamp, into the cutoff time before which
+// volumes are eligible for removal.
+func untilFilterValue(pruneFilters filters.Args) (time.Time, bool, error) {
+	values := pruneFilters.Get("until")
+	if len(values) == 0 {
+		return time.Time{}, false, nil
+	}
+	v := values[0]
+	if d, err := time.ParseDuration(v); err == nil {
+		return time.Now().Add(-d), true, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, false, errors.Wrapf(err, "invalid until filter %q", v)
+	}
+	return t, true, nil
 }
 
 // RunPrune calls the Volume Prune API
-// This returns the amount of space reclaimed and a detailed output string
-func RunPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (uint64, string, error) {
+// This returns a structured report of what was (or would be) removed
+func RunPrune(dockerCli command.Cli, dryRun bool, filter opts.FilterOpt) (*report.Report, error) {
 	return runPrune(dockerCli, pruneOptions{force: true, dryRun: dryRun, filter: filter})
 }
@@ -1,6 +1,7 @@
 package volume
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"runtime"
@@ -38,6 +39,13 @@ func TestVolumePruneErrors(t *testing.T) {
 			},
 			expectedError: "error pruning volumes",
 		},
+		{
+			flags: map[string]string{
+				"force":  "true",
+				"filter": "badfilter=baz",
+			},
+			expectedError: "Invalid filter",
+		},
 	}
 	for _, tc := range testCases {
 		cmd := NewPruneCommand(
@@ -81,15 +89,23 @@ func TestVolumePruneForce(t *testing.T) {
 
 func TestVolumePruneDryRun(t *testing.T) {
 	testCases := []struct {
-		name            string
-		volumePruneFunc func(args filters.Args) (types.VolumesPruneReport, error)
+		name           string
+		volumeListFunc func(args filters.Args) (types.VolumeListOKBody, error)
 	}{
 		{
 			name: "dryRunEmpty",
 		},
 		{
 			name: "dryRunDeleteVolumes",
-			volumePruneFunc: simplePruneFunc,
+			volumeListFunc: func(args filters.Args) (types.VolumeListOKBody, error) {
+				return types.VolumeListOKBody{
+					Volumes: []*types.Volume{
+						{Name: "foo", UsageData: &types.VolumeUsageData{Size: 1000}},
+						{Name: "bar", UsageData: &types.VolumeUsageData{Size: 500}},
+						{Name: "baz", UsageData: &types.VolumeUsageData{Size: 500}},
+					},
+				}, nil
+			},
 		},
 	}
 
@@ -97,7 +113,7 @@ func TestVolumePruneDryRun(t *testing.T) {
 		buf := new(bytes.Buffer)
 		cmd := NewPruneCommand(
 			test.NewFakeCli(&fakeClient{
-				volumePruneFunc: tc.volumePruneFunc,
+				volumeListFunc: tc.volumeListFunc,
 			}, buf),
 		)
 		cmd.Flags().Set("dry-run", "true")
@@ -109,6 +125,26 @@ func TestVolumePruneDryRun(t *testing.T) {
 
 }
 
+func TestVolumePruneDryRunNeverCallsVolumesPrune(t *testing.T) {
+	pruneCalled := false
+	cli := test.NewFakeCli(&fakeClient{
+		volumePruneFunc: func(args filters.Args) (types.VolumesPruneReport, error) {
+			pruneCalled = true
+			return types.VolumesPruneReport{}, nil
+		},
+		volumeListFunc: func(args filters.Args) (types.VolumeListOKBody, error) {
+			return types.VolumeListOKBody{
+				Volumes: []*types.Volume{{Name: "unused"}},
+			}, nil
+		},
+	})
+	cmd := NewPruneCommand(cli)
+	cmd.Flags().Set("dry-run", "true")
+	assert.NoError(t, cmd.Execute())
+	assert.False(t, pruneCalled, "VolumesPrune must not be called when --dry-run is set")
+	assert.Contains(t, cli.OutBuffer().String(), "unused")
+}
+
 func TestVolumePrunePromptYes(t *testing.T) {
 	// FIXME(vdemeester) make it work..
 	skip.IfCondition(t, runtime.GOOS == "windows", "TODO: fix test on windows")
@@ -141,6 +177,82 @@ func TestVolumePrunePromptNo(t *testing.T) {
 	}
 }
 
+func TestVolumePruneWithLabelFilter(t *testing.T) {
+	testCases := []struct {
+		name   string
+		filter string
+	}{
+		{name: "label", filter: "label=keep=true"},
+		{name: "labelBang", filter: "label!=env=prod"},
+	}
+	for _, tc := range testCases {
+		var gotArgs filters.Args
+		cli := test.NewFakeCli(&fakeClient{
+			volumePruneFunc: func(args filters.Args) (types.VolumesPruneReport, error) {
+				gotArgs = args
+				return types.VolumesPruneReport{}, nil
+			},
+		})
+		cmd := NewPruneCommand(cli)
+		cmd.Flags().Set("force", "true")
+		cmd.Flags().Set("filter", tc.filter)
+		assert.NoError(t, cmd.Execute())
+
+		parts := strings.SplitN(tc.filter, "=", 2)
+		assert.True(t, gotArgs.Include(parts[0]))
+	}
+}
+
+func TestVolumePruneDryRunWithSizeAndUntilFilters(t *testing.T) {
+	cli := test.NewFakeCli(&fakeClient{
+		volumeListFunc: func(args filters.Args) (types.VolumeListOKBody, error) {
+			return types.VolumeListOKBody{
+				Volumes: []*types.Volume{
+					{Name: "small", UsageData: &types.VolumeUsageData{Size: 10}, CreatedAt: "2020-01-01T00:00:00Z"},
+					{Name: "big", UsageData: &types.VolumeUsageData{Size: 2000000000}, CreatedAt: "2020-01-01T00:00:00Z"},
+				},
+			}, nil
+		},
+	})
+	cmd := NewPruneCommand(cli)
+	cmd.Flags().Set("dry-run", "true")
+	cmd.Flags().Set("filter", "size=>1GB")
+	cmd.Flags().Set("filter", "until=24h")
+	assert.NoError(t, cmd.Execute())
+	out := cli.OutBuffer().String()
+	assert.Contains(t, out, "big")
+	assert.NotContains(t, out, "small")
+}
+
+func TestVolumePruneWithSizeFilterDeletesOnlyMatches(t *testing.T) {
+	pruneCalled := false
+	var removed []string
+	cli := test.NewFakeCli(&fakeClient{
+		volumePruneFunc: func(args filters.Args) (types.VolumesPruneReport, error) {
+			pruneCalled = true
+			return types.VolumesPruneReport{}, nil
+		},
+		volumeListFunc: func(args filters.Args) (types.VolumeListOKBody, error) {
+			return types.VolumeListOKBody{
+				Volumes: []*types.Volume{
+					{Name: "small", UsageData: &types.VolumeUsageData{Size: 10}},
+					{Name: "big", UsageData: &types.VolumeUsageData{Size: 2000000000}},
+				},
+			}, nil
+		},
+		volumeRemoveFunc: func(name string, force bool) error {
+			removed = append(removed, name)
+			return nil
+		},
+	})
+	cmd := NewPruneCommand(cli)
+	cmd.Flags().Set("force", "true")
+	cmd.Flags().Set("filter", "size=>1GB")
+	assert.NoError(t, cmd.Execute())
+	assert.False(t, pruneCalled, "VolumesPrune must not be called when a size/until filter is set")
+	assert.Equal(t, []string{"big"}, removed)
+}
+
 func simplePruneFunc(args filters.Args) (types.VolumesPruneReport, error) {
 	return types.VolumesPruneReport{
 		VolumesDeleted: []string{